@@ -8,13 +8,32 @@ import (
 
 	"terraform-provider-zerossl/provider"
 
-	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 )
 
+// version is overridden at build time via -ldflags, following the usual
+// terraform-plugin-framework scaffolding convention.
+var version = "dev"
+
 func main() {
-	if err := tfsdk.Serve(context.Background(), provider.New, tfsdk.ServeOpts{
-		Name: "zerossl",
-	}); err != nil {
+	ctx := context.Background()
+
+	// The mux lets a future SDKv2-based subsystem, or additional
+	// terraform-plugin-framework providers, be combined under the same
+	// zerossl binary; today it only serves the one framework provider.
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(provider.New(version)()),
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := tf6server.Serve("registry.terraform.io/toowoxx/zerossl", muxServer.ProviderServer); err != nil {
 		log.Fatal(err)
 	}
 }
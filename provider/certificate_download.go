@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// downloadCertificate fetches the issued PEM chain via the "return" download
+// endpoint, which responds with the leaf certificate and CA bundle as plain
+// JSON string fields rather than a zip archive. It's shared by
+// certificateDataSource and certificateValidationResource, which both need
+// to turn a certificate ID into its issued PEM chain.
+func downloadCertificate(ctx context.Context, client *retryableClient, accessKey, id string) (certificatePEM, caBundlePEM string, err error) {
+	query := url.Values{"access_key": []string{accessKey}}
+	endpoint := fmt.Sprintf("%s/certificates/%s/download/return?%s", ZeroSSLBaseURL, url.PathEscape(id), query.Encode())
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", "", errors.Wrap(err, "could not create request")
+	}
+
+	httpResp, err := client.Do(ctx, httpReq)
+	if err != nil {
+		return "", "", errors.Wrap(err, "request failed")
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(httpResp.Body)
+		return "", "", fmt.Errorf("downloading certificate failed with HTTP status code %d %s: %s",
+			httpResp.StatusCode, http.StatusText(httpResp.StatusCode), string(body))
+	}
+
+	var download struct {
+		CertificateCrt string   `json:"certificate.crt"`
+		CABundleCrt    string   `json:"ca_bundle.crt"`
+		Error          apiError `json:"error"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&download); err != nil {
+		return "", "", errors.Wrap(err, "could not decode response")
+	}
+	if download.Error.Code != 0 {
+		return "", "", fmt.Errorf("server responded with error type %s and error code %d",
+			download.Error.Type, download.Error.Code)
+	}
+
+	return download.CertificateCrt, download.CABundleCrt, nil
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ datasource.DataSource              = &certificateDataSource{}
+	_ datasource.DataSourceWithConfigure = &certificateDataSource{}
+)
+
+// NewCertificateDataSource is a datasource.DataSource factory for
+// zerossl_certificate, as expected by zeroSSLProvider.DataSources.
+func NewCertificateDataSource() datasource.DataSource {
+	return &certificateDataSource{}
+}
+
+type certificateDataSource struct {
+	data *zeroSSLProviderData
+}
+
+type certificateDataSourceModel struct {
+	AccessKey         types.String `tfsdk:"access_key"`
+	ID                types.String `tfsdk:"id"`
+	Download          types.Bool   `tfsdk:"download"`
+	CommonName        types.String `tfsdk:"common_name"`
+	AdditionalDomains types.String `tfsdk:"additional_domains"`
+	Status            types.String `tfsdk:"status"`
+	Created           types.String `tfsdk:"created"`
+	Expires           types.String `tfsdk:"expires"`
+	FingerprintSHA1   types.String `tfsdk:"fingerprint_sha1"`
+	CertificatePEM    types.String `tfsdk:"certificate_pem"`
+	CABundlePEM       types.String `tfsdk:"ca_bundle_pem"`
+}
+
+type getCertificateResponse struct {
+	ID                string   `json:"id"`
+	CommonName        string   `json:"common_name"`
+	AdditionalDomains string   `json:"additional_domains"`
+	Status            string   `json:"status"`
+	Created           string   `json:"created"`
+	Expires           string   `json:"expires"`
+	FingerprintSHA1   string   `json:"fingerprint_sha1"`
+	Error             apiError `json:"error"`
+}
+
+func (d *certificateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (d *certificateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "ZeroSSL API access key ([view it here](https://app.zerossl.com/developer)). Falls " +
+					"back to the provider's `api_key` if not set.",
+			},
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ZeroSSL certificate ID to look up.",
+			},
+			"download": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether to also download the issued PEM chain via " +
+					"`GET /certificates/{id}/download/return`. Only works for certificates in the `issued` status.",
+			},
+			"common_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"additional_domains": schema.StringAttribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"created": schema.StringAttribute{
+				Computed: true,
+			},
+			"expires": schema.StringAttribute{
+				Computed: true,
+			},
+			"fingerprint_sha1": schema.StringAttribute{
+				Computed: true,
+			},
+			"certificate_pem": schema.StringAttribute{
+				Computed:    true,
+				Description: "The issued leaf certificate, in PEM format. Empty unless `download` is set.",
+			},
+			"ca_bundle_pem": schema.StringAttribute{
+				Computed:    true,
+				Description: "ZeroSSL's intermediate CA bundle, in PEM format. Empty unless `download` is set.",
+			},
+		},
+	}
+}
+
+func (d *certificateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected provider data", err.Error())
+		return
+	}
+	d.data = data
+}
+
+func (d *certificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config certificateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessKey, err := resolveAPIKey(config.AccessKey, d.data.APIKey)
+	if err != nil {
+		resp.Diagnostics.AddError("could not get certificate", err.Error())
+		return
+	}
+
+	cert, err := d.getCertificate(ctx, accessKey, config.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("could not get certificate", err.Error())
+		return
+	}
+
+	config.CommonName = types.StringValue(cert.CommonName)
+	config.AdditionalDomains = types.StringValue(cert.AdditionalDomains)
+	config.Status = types.StringValue(cert.Status)
+	config.Created = types.StringValue(cert.Created)
+	config.Expires = types.StringValue(cert.Expires)
+	config.FingerprintSHA1 = types.StringValue(cert.FingerprintSHA1)
+	config.CertificatePEM = types.StringValue("")
+	config.CABundlePEM = types.StringValue("")
+
+	if !config.Download.IsNull() && config.Download.ValueBool() {
+		certPEM, caBundlePEM, err := downloadCertificate(ctx, d.data.HTTPClient, accessKey, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("could not download certificate", err.Error())
+			return
+		}
+		config.CertificatePEM = types.StringValue(certPEM)
+		config.CABundlePEM = types.StringValue(caBundlePEM)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *certificateDataSource) getCertificate(ctx context.Context, accessKey, id string) (*getCertificateResponse, error) {
+	query := url.Values{"access_key": []string{accessKey}}
+	endpoint := fmt.Sprintf("%s/certificates/%s?%s", ZeroSSLBaseURL, url.PathEscape(id), query.Encode())
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+
+	httpResp, err := d.data.HTTPClient.Do(ctx, httpReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "request failed")
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("getting certificate failed with HTTP status code %d %s",
+			httpResp.StatusCode, http.StatusText(httpResp.StatusCode))
+	}
+
+	var result getCertificateResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
+		return nil, errors.Wrap(err, "could not decode response")
+	}
+	if result.Error.Code != 0 {
+		return nil, fmt.Errorf("server responded with error type %s and error code %d",
+			result.Error.Type, result.Error.Code)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func withTestZeroSSLServer(t *testing.T, handler http.HandlerFunc) *certificateDataSource {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	previous := ZeroSSLBaseURL
+	ZeroSSLBaseURL = server.URL
+	t.Cleanup(func() { ZeroSSLBaseURL = previous })
+
+	return &certificateDataSource{
+		data: &zeroSSLProviderData{
+			APIKey:     "",
+			HTTPClient: newRetryableClient(5*time.Second, 0, "test"),
+		},
+	}
+}
+
+func TestCertificateDataSourceGetCertificate(t *testing.T) {
+	d := withTestZeroSSLServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Path; got != "/certificates/cert-id" {
+			t.Errorf("unexpected request path: %s", got)
+		}
+		if got := r.URL.Query().Get("access_key"); got != "test-key" {
+			t.Errorf("access_key query param = %q, want %q", got, "test-key")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "cert-id",
+			"common_name": "example.com",
+			"additional_domains": "www.example.com",
+			"status": "issued",
+			"created": "2026-01-01 00:00:00",
+			"expires": "2026-04-01 00:00:00",
+			"fingerprint_sha1": "deadbeef"
+		}`))
+	})
+
+	cert, err := d.getCertificate(context.Background(), "test-key", "cert-id")
+	if err != nil {
+		t.Fatalf("getCertificate() returned unexpected error: %v", err)
+	}
+
+	if cert.CommonName != "example.com" || cert.Status != "issued" || cert.FingerprintSHA1 != "deadbeef" {
+		t.Fatalf("getCertificate() = %+v, missing expected fields", cert)
+	}
+}
+
+func TestCertificateDataSourceGetCertificateAPIError(t *testing.T) {
+	d := withTestZeroSSLServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"error": {"code": 2520, "type": "invalid_id"}}`))
+	})
+
+	if _, err := d.getCertificate(context.Background(), "test-key", "bogus-id"); err == nil {
+		t.Fatal("getCertificate() = nil error, want error for a ZeroSSL-reported error response")
+	}
+}
+
+func TestCertificateDataSourceGetCertificateHTTPError(t *testing.T) {
+	d := withTestZeroSSLServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := d.getCertificate(context.Background(), "test-key", "missing-id"); err == nil {
+		t.Fatal("getCertificate() = nil error, want error for a non-200 response")
+	}
+}
+
+func TestDownloadCertificate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Path; got != "/certificates/cert-id/download/return" {
+			t.Errorf("unexpected request path: %s", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"certificate.crt": "leaf-pem", "ca_bundle.crt": "bundle-pem"}`))
+	}))
+	defer server.Close()
+
+	previous := ZeroSSLBaseURL
+	ZeroSSLBaseURL = server.URL
+	defer func() { ZeroSSLBaseURL = previous }()
+
+	client := newRetryableClient(5*time.Second, 0, "test")
+	certPEM, caBundlePEM, err := downloadCertificate(context.Background(), client, "test-key", "cert-id")
+	if err != nil {
+		t.Fatalf("downloadCertificate() returned unexpected error: %v", err)
+	}
+	if certPEM != "leaf-pem" || caBundlePEM != "bundle-pem" {
+		t.Fatalf("downloadCertificate() = (%q, %q), want (%q, %q)", certPEM, caBundlePEM, "leaf-pem", "bundle-pem")
+	}
+}
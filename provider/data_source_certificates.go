@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ datasource.DataSource              = &certificatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &certificatesDataSource{}
+)
+
+// NewCertificatesDataSource is a datasource.DataSource factory for
+// zerossl_certificates, as expected by zeroSSLProvider.DataSources.
+func NewCertificatesDataSource() datasource.DataSource {
+	return &certificatesDataSource{}
+}
+
+type certificatesDataSource struct {
+	data *zeroSSLProviderData
+}
+
+type certificatesDataSourceModel struct {
+	AccessKey  types.String `tfsdk:"access_key"`
+	CommonName types.String `tfsdk:"common_name"`
+	Status     types.String `tfsdk:"status"`
+	Search     types.String `tfsdk:"search"`
+
+	Certificates []certificateListItem `tfsdk:"certificates"`
+}
+
+type certificateListItem struct {
+	ID                types.String `tfsdk:"id"`
+	CommonName        types.String `tfsdk:"common_name"`
+	AdditionalDomains types.String `tfsdk:"additional_domains"`
+	Status            types.String `tfsdk:"status"`
+	Created           types.String `tfsdk:"created"`
+	Expires           types.String `tfsdk:"expires"`
+	FingerprintSHA1   types.String `tfsdk:"fingerprint_sha1"`
+	ValidationDetails types.String `tfsdk:"validation_details"`
+}
+
+type listCertificatesResponse struct {
+	TotalCount  int `json:"total_count"`
+	ResultCount int `json:"result_count"`
+	Results     []struct {
+		ID                string          `json:"id"`
+		CommonName        string          `json:"common_name"`
+		AdditionalDomains string          `json:"additional_domains"`
+		Status            string          `json:"status"`
+		Created           string          `json:"created"`
+		Expires           string          `json:"expires"`
+		FingerprintSHA1   string          `json:"fingerprint_sha1"`
+		ValidationDetails json.RawMessage `json:"validation"`
+	} `json:"results"`
+	Error apiError `json:"error"`
+}
+
+type apiError struct {
+	Code int    `json:"code"`
+	Type string `json:"type"`
+}
+
+func (d *certificatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificates"
+}
+
+func (d *certificatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "ZeroSSL API access key ([view it here](https://app.zerossl.com/developer)). Falls " +
+					"back to the provider's `api_key` if not set.",
+			},
+			"common_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter results to certificates issued for this common name.",
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				Description: "Filter results by status: `draft`, `pending_validation`, `issued`, " +
+					"`expiring_soon`, `expired` or `revoked`.",
+			},
+			"search": schema.StringAttribute{
+				Optional:    true,
+				Description: "Free-text search string, passed through to ZeroSSL's `search` query parameter.",
+			},
+			"certificates": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"common_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"additional_domains": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+						"created": schema.StringAttribute{
+							Computed: true,
+						},
+						"expires": schema.StringAttribute{
+							Computed: true,
+						},
+						"fingerprint_sha1": schema.StringAttribute{
+							Computed: true,
+						},
+						"validation_details": schema.StringAttribute{
+							Computed:    true,
+							Description: "Raw JSON of the certificate's `validation` object, as returned by ZeroSSL.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *certificatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected provider data", err.Error())
+		return
+	}
+	d.data = data
+}
+
+func (d *certificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config certificatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessKey, err := resolveAPIKey(config.AccessKey, d.data.APIKey)
+	if err != nil {
+		resp.Diagnostics.AddError("could not list certificates", err.Error())
+		return
+	}
+
+	listResponse, err := d.listCertificates(ctx, accessKey, config.CommonName.ValueString(),
+		config.Status.ValueString(), config.Search.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("could not list certificates", err.Error())
+		return
+	}
+
+	config.Certificates = make([]certificateListItem, 0, len(listResponse.Results))
+	for _, result := range listResponse.Results {
+		config.Certificates = append(config.Certificates, certificateListItem{
+			ID:                types.StringValue(result.ID),
+			CommonName:        types.StringValue(result.CommonName),
+			AdditionalDomains: types.StringValue(result.AdditionalDomains),
+			Status:            types.StringValue(result.Status),
+			Created:           types.StringValue(result.Created),
+			Expires:           types.StringValue(result.Expires),
+			FingerprintSHA1:   types.StringValue(result.FingerprintSHA1),
+			ValidationDetails: types.StringValue(string(result.ValidationDetails)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listCertificates queries GET /certificates, optionally filtered by
+// commonName/status/search (each ignored when empty).
+func (d *certificatesDataSource) listCertificates(ctx context.Context, accessKey, commonName, status, search string) (*listCertificatesResponse, error) {
+	query := url.Values{"access_key": []string{accessKey}}
+	if commonName != "" {
+		query.Set("common_name", commonName)
+	}
+	if status != "" {
+		query.Set("certificate_status", status)
+	}
+	if search != "" {
+		query.Set("search", search)
+	}
+
+	endpoint := fmt.Sprintf("%s/certificates?%s", ZeroSSLBaseURL, query.Encode())
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+
+	httpResp, err := d.data.HTTPClient.Do(ctx, httpReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "request failed")
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("listing certificates failed with HTTP status code %d %s",
+			httpResp.StatusCode, http.StatusText(httpResp.StatusCode))
+	}
+
+	var listResponse listCertificatesResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&listResponse); err != nil {
+		return nil, errors.Wrap(err, "could not decode response")
+	}
+	if listResponse.Error.Code != 0 {
+		return nil, fmt.Errorf("server responded with error type %s and error code %d",
+			listResponse.Error.Type, listResponse.Error.Code)
+	}
+
+	return &listResponse, nil
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func withTestCertificatesDataSource(t *testing.T, handler http.HandlerFunc) *certificatesDataSource {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	previous := ZeroSSLBaseURL
+	ZeroSSLBaseURL = server.URL
+	t.Cleanup(func() { ZeroSSLBaseURL = previous })
+
+	return &certificatesDataSource{
+		data: &zeroSSLProviderData{
+			HTTPClient: newRetryableClient(5*time.Second, 0, "test"),
+		},
+	}
+}
+
+func TestCertificatesDataSourceListCertificatesQueryParams(t *testing.T) {
+	var gotQuery url.Values
+
+	d := withTestCertificatesDataSource(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"total_count": 0, "result_count": 0, "results": []}`))
+	})
+
+	_, err := d.listCertificates(context.Background(), "test-key", "example.com", "issued", "needle")
+	if err != nil {
+		t.Fatalf("listCertificates() returned unexpected error: %v", err)
+	}
+
+	if got := gotQuery.Get("access_key"); got != "test-key" {
+		t.Errorf("access_key = %q, want %q", got, "test-key")
+	}
+	if got := gotQuery.Get("common_name"); got != "example.com" {
+		t.Errorf("common_name = %q, want %q", got, "example.com")
+	}
+	if got := gotQuery.Get("certificate_status"); got != "issued" {
+		t.Errorf("certificate_status = %q, want %q", got, "issued")
+	}
+	if got := gotQuery.Get("search"); got != "needle" {
+		t.Errorf("search = %q, want %q", got, "needle")
+	}
+}
+
+func TestCertificatesDataSourceListCertificatesOmitsEmptyFilters(t *testing.T) {
+	var gotQuery url.Values
+
+	d := withTestCertificatesDataSource(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"total_count": 0, "result_count": 0, "results": []}`))
+	})
+
+	if _, err := d.listCertificates(context.Background(), "test-key", "", "", ""); err != nil {
+		t.Fatalf("listCertificates() returned unexpected error: %v", err)
+	}
+
+	for _, key := range []string{"common_name", "certificate_status", "search"} {
+		if gotQuery.Has(key) {
+			t.Errorf("query unexpectedly set %q, want it omitted when the filter is empty", key)
+		}
+	}
+}
+
+func TestCertificatesDataSourceListCertificatesDecodesResults(t *testing.T) {
+	d := withTestCertificatesDataSource(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"total_count": 1,
+			"result_count": 1,
+			"results": [{
+				"id": "cert-id",
+				"common_name": "example.com",
+				"status": "issued",
+				"validation": {"example.com": {"method": "HTTP_CSR_HASH"}}
+			}]
+		}`))
+	})
+
+	listResponse, err := d.listCertificates(context.Background(), "test-key", "", "", "")
+	if err != nil {
+		t.Fatalf("listCertificates() returned unexpected error: %v", err)
+	}
+
+	if len(listResponse.Results) != 1 {
+		t.Fatalf("len(Results) = %d, want 1", len(listResponse.Results))
+	}
+	result := listResponse.Results[0]
+	if result.ID != "cert-id" || result.CommonName != "example.com" || result.Status != "issued" {
+		t.Fatalf("listCertificates() result = %+v, missing expected fields", result)
+	}
+	if len(result.ValidationDetails) == 0 {
+		t.Fatal("ValidationDetails was not populated from the raw validation object")
+	}
+}
+
+func TestCertificatesDataSourceListCertificatesAPIError(t *testing.T) {
+	d := withTestCertificatesDataSource(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"error": {"code": 2520, "type": "invalid_key"}}`))
+	})
+
+	if _, err := d.listCertificates(context.Background(), "bad-key", "", "", ""); err == nil {
+		t.Fatal("listCertificates() = nil error, want error for a ZeroSSL-reported error response")
+	}
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultRequestTimeout = 30 * time.Second
+	defaultMaxRetries     = 4
+
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+)
+
+// retryableClient wraps an *http.Client with exponential backoff and jitter
+// for the transient failure modes ZeroSSL's API is known to return under
+// load, so every resource and data source in this provider behaves
+// consistently instead of each reimplementing its own retry loop.
+type retryableClient struct {
+	client     *http.Client
+	maxRetries int
+	userAgent  string
+}
+
+func newRetryableClient(timeout time.Duration, maxRetries int, userAgentSuffix string) *retryableClient {
+	if timeout <= 0 {
+		timeout = defaultRequestTimeout
+	}
+	if maxRetries < 0 {
+		maxRetries = defaultMaxRetries
+	}
+
+	userAgent := "terraform-provider-zerossl"
+	if userAgentSuffix != "" {
+		userAgent = userAgent + " " + userAgentSuffix
+	}
+
+	return &retryableClient{
+		client:     &http.Client{Timeout: timeout},
+		maxRetries: maxRetries,
+		userAgent:  userAgent,
+	}
+}
+
+// Do sends req, retrying retryable statuses (429, 502, 503, 504) and network
+// errors with exponential backoff and jitter. It honors a Retry-After header
+// when ZeroSSL sends one. req.Body must be nil or support GetBody, since it
+// may be replayed across attempts.
+func (c *retryableClient) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req.Header.Set("User-Agent", c.userAgent)
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			delay := backoffDelay(attempt)
+			tflog.Debug(ctx, "retrying ZeroSSL API request", map[string]interface{}{
+				"method":  req.Method,
+				"url":     req.URL.String(),
+				"attempt": attempt,
+				"delay":   delay.String(),
+			})
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		attemptReq := req.Clone(ctx)
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+
+		tflog.Debug(ctx, "sending ZeroSSL API request", map[string]interface{}{
+			"method": attemptReq.Method,
+			"url":    attemptReq.URL.String(),
+		})
+
+		resp, err := c.client.Do(attemptReq)
+		if err != nil {
+			lastErr = err
+			tflog.Debug(ctx, "ZeroSSL API request failed", map[string]interface{}{"error": err.Error()})
+			continue
+		}
+
+		tflog.Debug(ctx, "received ZeroSSL API response", map[string]interface{}{"status": resp.StatusCode})
+
+		if !isRetryableStatus(resp.StatusCode) || attempt == c.maxRetries {
+			return resp, nil
+		}
+
+		if wait, ok := retryAfter(resp); ok {
+			resp.Body.Close()
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(wait):
+			}
+			continue
+		}
+
+		resp.Body.Close()
+	}
+
+	return nil, lastErr
+}
+
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(header); err == nil {
+		return time.Until(when), true
+	}
+
+	return 0, false
+}
+
+// backoffDelay returns an exponential delay with full jitter, capped at
+// retryMaxDelay.
+func backoffDelay(attempt int) time.Duration {
+	max := float64(retryBaseDelay) * math.Pow(2, float64(attempt-1))
+	if max > float64(retryMaxDelay) {
+		max = float64(retryMaxDelay)
+	}
+
+	return time.Duration(rand.Int63n(int64(max)))
+}
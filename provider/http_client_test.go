@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		want   bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusBadRequest, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusGatewayTimeout, true},
+		{http.StatusInternalServerError, false},
+	}
+
+	for _, tt := range tests {
+		if got := isRetryableStatus(tt.status); got != tt.want {
+			t.Errorf("isRetryableStatus(%d) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Run("seconds", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		resp.Header().Set("Retry-After", "5")
+		wait, ok := retryAfter(resp.Result())
+		if !ok || wait != 5*time.Second {
+			t.Fatalf("retryAfter() = (%v, %v), want (5s, true)", wait, ok)
+		}
+	})
+
+	t.Run("missing header", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		_, ok := retryAfter(resp.Result())
+		if ok {
+			t.Fatalf("retryAfter() reported a value with no Retry-After header present")
+		}
+	})
+
+	t.Run("http date", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		when := time.Now().Add(10 * time.Second)
+		resp.Header().Set("Retry-After", when.UTC().Format(http.TimeFormat))
+		wait, ok := retryAfter(resp.Result())
+		if !ok || wait <= 0 || wait > 11*time.Second {
+			t.Fatalf("retryAfter() = (%v, %v), want a positive duration near 10s", wait, ok)
+		}
+	})
+}
+
+func TestBackoffDelay(t *testing.T) {
+	for attempt := 1; attempt <= 6; attempt++ {
+		delay := backoffDelay(attempt)
+		if delay < 0 || delay > retryMaxDelay {
+			t.Errorf("backoffDelay(%d) = %v, want within [0, %v]", attempt, delay, retryMaxDelay)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ZeroSSLBaseURL is the base URL of the ZeroSSL REST API used by every
+// resource and data source in this provider. It's a var, rather than a
+// const, so tests can point it at an httptest.Server.
+var ZeroSSLBaseURL = "https://api.zerossl.com"
+
+// Ensure zeroSSLProvider satisfies the expected framework interfaces.
+var _ provider.Provider = &zeroSSLProvider{}
+
+// zeroSSLProvider satisfies the provider.Provider interface and is shared,
+// via zeroSSLProviderData, by every Resource and DataSource implementation
+// in this package.
+type zeroSSLProvider struct {
+	// version is injected at build time (see main.go) and reported to
+	// Terraform as the provider version in Metadata.
+	version string
+}
+
+// zeroSSLProviderModel mirrors the provider's configuration block.
+type zeroSSLProviderModel struct {
+	APIKey         types.String `tfsdk:"api_key"`
+	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	UserAgent      types.String `tfsdk:"user_agent"`
+}
+
+// zeroSSLProviderData is handed to every resource and data source via
+// resp.ResourceData/resp.DataSourceData in Configure, so timeout, retry and
+// default-credential behavior stay consistent across the whole provider.
+type zeroSSLProviderData struct {
+	APIKey     string
+	HTTPClient *retryableClient
+}
+
+// New returns a provider.Provider factory, as expected by
+// providerserver.NewProtocol6. version should be set to the provider's
+// released version (or "dev" for local builds) by main.go.
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &zeroSSLProvider{version: version}
+	}
+}
+
+func (p *zeroSSLProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "zerossl"
+	resp.Version = p.version
+}
+
+func (p *zeroSSLProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				Optional: true,
+				Description: "Default ZeroSSL API key ([view it here](https://app.zerossl.com/developer)) used by " +
+					"resources and data sources that do not set their own `api_key`/`access_key`.",
+			},
+			"request_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout, in seconds, for a single HTTP request to the ZeroSSL API. Defaults to 30.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum number of retries for requests that fail with a retryable status " +
+					"(429, 502, 503, 504) or a network error. Defaults to 4.",
+			},
+			"user_agent": schema.StringAttribute{
+				Optional:    true,
+				Description: "Suffix appended to the provider's User-Agent header, so operators can identify traffic from specific Terraform runs.",
+			},
+		},
+	}
+}
+
+func (p *zeroSSLProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data zeroSSLProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultRequestTimeout
+	if !data.RequestTimeout.IsNull() {
+		timeout = time.Duration(data.RequestTimeout.ValueInt64()) * time.Second
+	}
+
+	maxRetries := defaultMaxRetries
+	if !data.MaxRetries.IsNull() {
+		maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	providerData := &zeroSSLProviderData{
+		APIKey:     data.APIKey.ValueString(),
+		HTTPClient: newRetryableClient(timeout, maxRetries, data.UserAgent.ValueString()),
+	}
+
+	resp.ResourceData = providerData
+	resp.DataSourceData = providerData
+}
+
+func (p *zeroSSLProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewEABCredentialsResource,
+		NewCertificateResource,
+		NewCertificateValidationResource,
+	}
+}
+
+func (p *zeroSSLProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewCertificateDataSource,
+		NewCertificatesDataSource,
+	}
+}
+
+// resolveAPIKey returns the api_key/access_key a resource or data source
+// should authenticate with: its own configured value if set, falling back
+// to the provider-level api_key otherwise.
+func resolveAPIKey(configured types.String, providerAPIKey string) (string, error) {
+	if !configured.IsNull() && configured.ValueString() != "" {
+		return configured.ValueString(), nil
+	}
+	if providerAPIKey != "" {
+		return providerAPIKey, nil
+	}
+	return "", fmt.Errorf("api_key must be set on the resource/data source or on the provider")
+}
+
+// configureFromProviderData extracts *zeroSSLProviderData out of the
+// ProviderData handed to a Resource/DataSource's Configure method. It's a
+// no-op until Terraform has called the provider's own Configure (e.g. during
+// validation), which every Resource/DataSource Configure method relies on.
+func configureFromProviderData(raw any) (*zeroSSLProviderData, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	data, ok := raw.(*zeroSSLProviderData)
+	if !ok {
+		return nil, fmt.Errorf("unexpected provider data type: expected *zeroSSLProviderData, got %T", raw)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccProtoV6ProviderFactories instantiates the provider the same way
+// main.go serves it in production (protocol 6, via providerserver), so
+// acceptance tests in this package exercise the real wire format rather
+// than calling resource/data source methods directly.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"zerossl": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccPreCheck is called by every acceptance test's PreCheck. resource.Test
+// already skips the whole test unless TF_ACC is set, so this only needs to
+// validate that the credentials those tests require are actually present.
+func testAccPreCheck(t *testing.T) {
+	if os.Getenv("ZEROSSL_API_KEY") == "" {
+		t.Fatal("ZEROSSL_API_KEY must be set for acceptance tests")
+	}
+}
+
+// TestAccEABCredentialsResource_basic exercises zerossl_eab_credentials
+// end-to-end against the real ZeroSSL API, gated behind TF_ACC like every
+// other acceptance test in the Terraform ecosystem.
+func TestAccEABCredentialsResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "zerossl_eab_credentials" "test" {
+  api_key = "` + os.Getenv("ZEROSSL_API_KEY") + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("zerossl_eab_credentials.test", "kid"),
+					resource.TestCheckResourceAttrSet("zerossl_eab_credentials.test", "hmac_key"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,583 @@
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/certificate"
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/lego"
+	"github.com/go-acme/lego/v4/providers/dns/cloudflare"
+	"github.com/go-acme/lego/v4/providers/dns/gandi"
+	"github.com/go-acme/lego/v4/providers/dns/route53"
+	"github.com/go-acme/lego/v4/registration"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/pkg/errors"
+)
+
+// zeroSSLACMEDirectoryURL is ZeroSSL's production ACME v2 directory.
+const zeroSSLACMEDirectoryURL = "https://acme.zerossl.com/v2/DV90"
+
+var (
+	_ resource.Resource                = &certificateResource{}
+	_ resource.ResourceWithConfigure   = &certificateResource{}
+	_ resource.ResourceWithImportState = &certificateResource{}
+	_ resource.ResourceWithModifyPlan  = &certificateResource{}
+)
+
+// NewCertificateResource is a resource.Resource factory for
+// zerossl_certificate, as expected by zeroSSLProvider.Resources.
+func NewCertificateResource() resource.Resource {
+	return &certificateResource{}
+}
+
+type certificateResource struct {
+	data *zeroSSLProviderData
+}
+
+type http01ChallengeModel struct {
+	Mode    types.String `tfsdk:"mode"`
+	Webroot types.String `tfsdk:"webroot"`
+}
+
+type dns01ChallengeModel struct {
+	Provider    types.String `tfsdk:"provider"`
+	Credentials types.Map    `tfsdk:"credentials"`
+}
+
+type challengeModel struct {
+	HTTP01 types.Object `tfsdk:"http_01"`
+	DNS01  types.Object `tfsdk:"dns_01"`
+}
+
+type certificateResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	CommonName              types.String `tfsdk:"common_name"`
+	SubjectAlternativeNames types.List   `tfsdk:"subject_alternative_names"`
+	KeyType                 types.String `tfsdk:"key_type"`
+	EABKID                  types.String `tfsdk:"eab_kid"`
+	EABHMACKey              types.String `tfsdk:"eab_hmac_key"`
+	MinDaysRemaining        types.Int64  `tfsdk:"min_days_remaining"`
+	Challenge               types.Object `tfsdk:"challenge"`
+
+	CertificatePEM types.String `tfsdk:"certificate_pem"`
+	IssuerPEM      types.String `tfsdk:"issuer_pem"`
+	PrivateKeyPEM  types.String `tfsdk:"private_key_pem"`
+	NotBefore      types.String `tfsdk:"not_before"`
+	NotAfter       types.String `tfsdk:"not_after"`
+	Serial         types.String `tfsdk:"serial"`
+}
+
+func (r *certificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (r *certificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"common_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The primary domain name the certificate is issued for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subject_alternative_names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Additional domain names to include on the certificate.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_type": schema.StringAttribute{
+				Optional: true,
+				Description: "Private key algorithm: one of `RSA2048`, `RSA4096`, `ECDSA_P256` or " +
+					"`ECDSA_P384`. Defaults to `RSA2048`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"eab_kid": schema.StringAttribute{
+				Required:    true,
+				Description: "The `kid` produced by a `zerossl_eab_credentials` resource.",
+			},
+			"eab_hmac_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The `hmac_key` produced by a `zerossl_eab_credentials` resource.",
+			},
+			"min_days_remaining": schema.Int64Attribute{
+				Optional: true,
+				Description: "If the current certificate has fewer than this many days left until " +
+					"`not_after`, the next plan forces reissuance. Defaults to 30.",
+			},
+			"challenge": schema.SingleNestedAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"http_01": schema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"mode": schema.StringAttribute{
+								Required: true,
+								Description: "How to serve the HTTP-01 challenge response. Currently only " +
+									"`webroot` (write to a directory on disk) is supported.",
+							},
+							"webroot": schema.StringAttribute{
+								Optional:    true,
+								Description: "Directory to write `.well-known/acme-challenge` files to when `mode` is `webroot`.",
+							},
+						},
+					},
+					"dns_01": schema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"provider": schema.StringAttribute{
+								Required:    true,
+								Description: "The DNS provider to solve the challenge with, e.g. `cloudflare`, `route53`, `gandi`.",
+							},
+							"credentials": schema.MapAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "Provider-specific credentials, passed through as environment variables to the underlying go-acme/lego DNS provider.",
+							},
+						},
+					},
+				},
+			},
+			"certificate_pem": schema.StringAttribute{
+				Computed: true,
+			},
+			"issuer_pem": schema.StringAttribute{
+				Computed: true,
+			},
+			"private_key_pem": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"not_before": schema.StringAttribute{
+				Computed: true,
+			},
+			"not_after": schema.StringAttribute{
+				Computed: true,
+			},
+			"serial": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *certificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected provider data", err.Error())
+		return
+	}
+	r.data = data
+}
+
+// acmeUser implements registration.User for the lifetime of a single
+// Create/Update call; ZeroSSL EAB accounts are re-registered on every run
+// rather than persisted, since nothing about the account changes between
+// certificate requests.
+type acmeUser struct {
+	email        string
+	registration *registration.Resource
+	key          crypto.PrivateKey
+}
+
+func (u *acmeUser) GetEmail() string                        { return u.email }
+func (u *acmeUser) GetRegistration() *registration.Resource { return u.registration }
+func (u *acmeUser) GetPrivateKey() crypto.PrivateKey        { return u.key }
+
+func generatePrivateKey(keyType string) (crypto.PrivateKey, certcrypto.KeyType, error) {
+	switch strings.ToUpper(keyType) {
+	case "", "RSA2048":
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		return key, certcrypto.RSA2048, err
+	case "RSA4096":
+		key, err := rsa.GenerateKey(rand.Reader, 4096)
+		return key, certcrypto.RSA4096, err
+	case "ECDSA_P256":
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		return key, certcrypto.EC256, err
+	case "ECDSA_P384":
+		key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		return key, certcrypto.EC384, err
+	default:
+		return nil, "", fmt.Errorf("unsupported key_type %q", keyType)
+	}
+}
+
+// newACMEClient registers an ACME account against ZeroSSL's DV90 endpoint
+// using the EAB credentials produced by a zerossl_eab_credentials resource,
+// and configures whichever challenge solver the resource's config selects.
+func (r *certificateResource) newACMEClient(ctx context.Context, state *certificateResourceModel) (*lego.Client, error) {
+	accountKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate ACME account key")
+	}
+
+	user := &acmeUser{
+		email: fmt.Sprintf("%s@users.noreply.zerossl", uuid.Must(uuid.NewRandom()).String()),
+		key:   accountKey,
+	}
+
+	cfg := lego.NewConfig(user)
+	cfg.CADirURL = zeroSSLACMEDirectoryURL
+	cfg.Certificate.KeyType = certcrypto.RSA2048
+
+	client, err := lego.NewClient(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create ACME client")
+	}
+
+	reg, err := client.Registration.RegisterWithExternalAccountBinding(registration.RegisterEABOptions{
+		TermsOfServiceAgreed: true,
+		Kid:                  state.EABKID.ValueString(),
+		HmacEncoded:          state.EABHMACKey.ValueString(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not register ACME account with ZeroSSL")
+	}
+	user.registration = reg
+
+	if err := r.configureChallenges(ctx, client, state); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func (r *certificateResource) configureChallenges(ctx context.Context, client *lego.Client, state *certificateResourceModel) error {
+	var cfg challengeModel
+	diags := state.Challenge.As(ctx, &cfg, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return fmt.Errorf("could not read challenge configuration")
+	}
+
+	if !cfg.HTTP01.IsNull() && !cfg.DNS01.IsNull() {
+		return fmt.Errorf("challenge must configure exactly one of http_01 or dns_01")
+	}
+
+	if !cfg.HTTP01.IsNull() {
+		var http01Cfg http01ChallengeModel
+		if diags := cfg.HTTP01.As(ctx, &http01Cfg, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return fmt.Errorf("could not read http_01 configuration")
+		}
+
+		switch http01Cfg.Mode.ValueString() {
+		case "webroot":
+			provider, err := newWebrootProvider(http01Cfg.Webroot.ValueString())
+			if err != nil {
+				return errors.Wrap(err, "could not create webroot HTTP-01 provider")
+			}
+			return client.Challenge.SetHTTP01Provider(provider)
+		default:
+			return fmt.Errorf("unsupported http_01 mode %q", http01Cfg.Mode.ValueString())
+		}
+	}
+
+	if !cfg.DNS01.IsNull() {
+		var dns01Cfg dns01ChallengeModel
+		if diags := cfg.DNS01.As(ctx, &dns01Cfg, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return fmt.Errorf("could not read dns_01 configuration")
+		}
+
+		var credentials map[string]string
+		if !dns01Cfg.Credentials.IsNull() {
+			if diags := dns01Cfg.Credentials.ElementsAs(ctx, &credentials, false); diags.HasError() {
+				return fmt.Errorf("could not read dns_01 credentials")
+			}
+		}
+
+		dnsProvider, err := newDNSProvider(dns01Cfg.Provider.ValueString(), credentials)
+		if err != nil {
+			return err
+		}
+		return client.Challenge.SetDNS01Provider(dnsProvider)
+	}
+
+	return fmt.Errorf("challenge must configure exactly one of http_01 or dns_01")
+}
+
+// newDNSProvider constructs a go-acme/lego DNS provider by name. credentials
+// are set as environment variables before construction, matching the
+// provider-specific env vars (e.g. CF_DNS_API_TOKEN, AWS_ACCESS_KEY_ID) that
+// each go-acme/lego DNS provider reads in its own NewDNSProvider.
+func newDNSProvider(name string, credentials map[string]string) (challenge01DNSProvider, error) {
+	for key, value := range credentials {
+		if err := os.Setenv(key, value); err != nil {
+			return nil, errors.Wrapf(err, "could not set %s", key)
+		}
+	}
+
+	switch name {
+	case "cloudflare":
+		return cloudflare.NewDNSProvider()
+	case "route53":
+		return route53.NewDNSProvider()
+	case "gandi":
+		return gandi.NewDNSProvider()
+	default:
+		return nil, fmt.Errorf("unsupported dns_01 provider %q", name)
+	}
+}
+
+// challenge01DNSProvider is the subset of challenge.Provider implemented by
+// every go-acme/lego DNS provider constructor we call above.
+type challenge01DNSProvider = interface {
+	Present(domain, token, keyAuth string) error
+	CleanUp(domain, token, keyAuth string) error
+}
+
+// webrootChallengeDir is the path segment ACME clients serve HTTP-01
+// challenge responses from, relative to a webroot directory.
+const webrootChallengeDir = ".well-known/acme-challenge"
+
+// webrootProvider implements challenge.Provider by writing the HTTP-01 key
+// authorization to a file under root, for an external web server (e.g.
+// nginx serving the same document root) to serve back to the ACME server.
+type webrootProvider struct {
+	root string
+}
+
+var _ challenge.Provider = &webrootProvider{}
+
+func newWebrootProvider(root string) (*webrootProvider, error) {
+	if root == "" {
+		return nil, fmt.Errorf("webroot must be set when http_01 mode is %q", "webroot")
+	}
+	return &webrootProvider{root: root}, nil
+}
+
+func (w *webrootProvider) challengeFilePath(token string) string {
+	return filepath.Join(w.root, webrootChallengeDir, token)
+}
+
+func (w *webrootProvider) Present(_, token, keyAuth string) error {
+	path := w.challengeFilePath(token)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.Wrap(err, "could not create acme-challenge directory")
+	}
+	return os.WriteFile(path, []byte(keyAuth), 0o644)
+}
+
+func (w *webrootProvider) CleanUp(_, token, _ string) error {
+	return os.Remove(w.challengeFilePath(token))
+}
+
+func (r *certificateResource) issue(ctx context.Context, state *certificateResourceModel) error {
+	client, err := r.newACMEClient(ctx, state)
+	if err != nil {
+		return err
+	}
+
+	certKey, _, err := generatePrivateKey(state.KeyType.ValueString())
+	if err != nil {
+		return errors.Wrap(err, "could not generate certificate private key")
+	}
+
+	var sans []string
+	diags := state.SubjectAlternativeNames.ElementsAs(ctx, &sans, false)
+	if diags.HasError() {
+		return fmt.Errorf("could not read subject_alternative_names")
+	}
+
+	domains := append([]string{state.CommonName.ValueString()}, sans...)
+
+	request := certificate.ObtainRequest{
+		Domains:    domains,
+		Bundle:     true,
+		PrivateKey: certKey,
+	}
+
+	cert, err := client.Certificate.Obtain(request)
+	if err != nil {
+		return errors.Wrap(err, "could not obtain certificate from ZeroSSL")
+	}
+
+	// cert.Certificate is PEM-encoded, and - since Bundle is set above - may
+	// be a bundle of the leaf certificate followed by the issuer's; only the
+	// first block is the leaf we want to read NotBefore/NotAfter/Serial from.
+	block, _ := pem.Decode(cert.Certificate)
+	if block == nil {
+		return errors.New("could not decode issued certificate PEM")
+	}
+
+	parsed, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return errors.Wrap(err, "could not parse issued certificate")
+	}
+
+	privateKeyPEM := certcrypto.PEMEncode(certKey)
+
+	if state.ID.IsUnknown() || state.ID.ValueString() == "" {
+		state.ID = types.StringValue(uuid.Must(uuid.NewRandom()).String())
+	}
+	state.CertificatePEM = types.StringValue(string(cert.Certificate))
+	state.IssuerPEM = types.StringValue(string(cert.IssuerCertificate))
+	state.PrivateKeyPEM = types.StringValue(string(privateKeyPEM))
+
+	state.NotBefore = types.StringValue(parsed.NotBefore.UTC().Format(time.RFC3339))
+	state.NotAfter = types.StringValue(parsed.NotAfter.UTC().Format(time.RFC3339))
+	state.Serial = types.StringValue(parsed.SerialNumber.String())
+
+	return nil
+}
+
+func (r *certificateResource) needsRenewal(state certificateResourceModel) bool {
+	if state.NotAfter.IsNull() || state.NotAfter.ValueString() == "" {
+		return true
+	}
+
+	notAfter, err := time.Parse(time.RFC3339, state.NotAfter.ValueString())
+	if err != nil {
+		return true
+	}
+
+	minDaysRemaining := int64(30)
+	if !state.MinDaysRemaining.IsNull() {
+		minDaysRemaining = state.MinDaysRemaining.ValueInt64()
+	}
+
+	return time.Until(notAfter) < time.Duration(minDaysRemaining)*24*time.Hour
+}
+
+func (r *certificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state certificateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.issue(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("could not issue certificate", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *certificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state certificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ModifyPlan forces a diff once the certificate in state is within
+// min_days_remaining of expiry, even though every config attribute is
+// unchanged - without it, Update (and needsRenewal) is unreachable except by
+// editing an input, and an expiring certificate would never be reissued by
+// terraform plan/apply on its own.
+func (r *certificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		// Create or destroy: nothing in state yet to judge renewal against.
+		return
+	}
+
+	var priorState certificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.needsRenewal(priorState) {
+		return
+	}
+
+	var plan certificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.CertificatePEM = types.StringUnknown()
+	plan.IssuerPEM = types.StringUnknown()
+	plan.PrivateKeyPEM = types.StringUnknown()
+	plan.NotBefore = types.StringUnknown()
+	plan.NotAfter = types.StringUnknown()
+	plan.Serial = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *certificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan certificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorState certificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// common_name, subject_alternative_names, key_type and challenge all
+	// require replace, so Update only runs for min_days_remaining, eab_kid or
+	// eab_hmac_key changing, or the certificate approaching expiry - the
+	// identity of what was issued never changes underneath an in-place update.
+	plan.ID = priorState.ID
+	plan.CertificatePEM = priorState.CertificatePEM
+	plan.IssuerPEM = priorState.IssuerPEM
+	plan.PrivateKeyPEM = priorState.PrivateKeyPEM
+	plan.NotBefore = priorState.NotBefore
+	plan.NotAfter = priorState.NotAfter
+	plan.Serial = priorState.Serial
+
+	if r.needsRenewal(plan) {
+		if err := r.issue(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("could not reissue certificate", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *certificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state certificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+}
+
+func (r *certificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddError(
+		"import not supported",
+		"zerossl_certificate cannot be imported because ZeroSSL does not return private keys for "+
+			"previously issued certificates; use the zerossl_certificate data source to reference existing certificates instead.",
+	)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCertificateResourceNeedsRenewal(t *testing.T) {
+	r := &certificateResource{}
+
+	tests := []struct {
+		name             string
+		notAfter         types.String
+		minDaysRemaining types.Int64
+		want             bool
+	}{
+		{
+			name:     "no certificate yet",
+			notAfter: types.StringNull(),
+			want:     true,
+		},
+		{
+			name:     "unparseable not_after",
+			notAfter: types.StringValue("not-a-time"),
+			want:     true,
+		},
+		{
+			name:             "well outside min_days_remaining window",
+			notAfter:         types.StringValue(time.Now().Add(120 * 24 * time.Hour).UTC().Format(time.RFC3339)),
+			minDaysRemaining: types.Int64Value(30),
+			want:             false,
+		},
+		{
+			name:             "inside min_days_remaining window",
+			notAfter:         types.StringValue(time.Now().Add(10 * 24 * time.Hour).UTC().Format(time.RFC3339)),
+			minDaysRemaining: types.Int64Value(30),
+			want:             true,
+		},
+		{
+			name:     "already expired, default min_days_remaining",
+			notAfter: types.StringValue(time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)),
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := certificateResourceModel{
+				NotAfter:         tt.notAfter,
+				MinDaysRemaining: tt.minDaysRemaining,
+			}
+			if got := r.needsRenewal(state); got != tt.want {
+				t.Fatalf("needsRenewal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultValidationTimeout      = 5 * time.Minute
+	defaultValidationPollInterval = 5 * time.Second
+)
+
+var (
+	_ resource.Resource              = &certificateValidationResource{}
+	_ resource.ResourceWithConfigure = &certificateValidationResource{}
+)
+
+// NewCertificateValidationResource is a resource.Resource factory for
+// zerossl_certificate_validation, as expected by zeroSSLProvider.Resources.
+func NewCertificateValidationResource() resource.Resource {
+	return &certificateValidationResource{}
+}
+
+type certificateValidationResource struct {
+	data *zeroSSLProviderData
+}
+
+type certificateValidationResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	CertificateID    types.String `tfsdk:"certificate_id"`
+	ValidationMethod types.String `tfsdk:"validation_method"`
+	Timeout          types.Int64  `tfsdk:"timeout"`
+	PollInterval     types.Int64  `tfsdk:"poll_interval"`
+
+	CertificatePEM types.String `tfsdk:"certificate_pem"`
+	CABundlePEM    types.String `tfsdk:"ca_bundle_pem"`
+}
+
+func (r *certificateValidationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_validation"
+}
+
+func (r *certificateValidationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Drives a ZeroSSL certificate through domain-control validation and waits for it to " +
+			"reach `issued`, so that downstream resources can use `depends_on` to gate on a certificate " +
+			"really being ready rather than merely requested.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ZeroSSL certificate ID to validate, as returned by the `zerossl_certificates` data source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"validation_method": schema.StringAttribute{
+				Required: true,
+				Description: "How ZeroSSL should validate domain control: `HTTP_CSR_HASH`, " +
+					"`CNAME_CSR_HASH` or `EMAIL`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional: true,
+				Description: "Maximum time, in seconds, to wait for the certificate to reach `issued`. " +
+					"Defaults to 300.",
+			},
+			"poll_interval": schema.Int64Attribute{
+				Optional: true,
+				Description: "How often, in seconds, to poll `/certificates/{id}/status` while waiting. " +
+					"Defaults to 5.",
+			},
+			"certificate_pem": schema.StringAttribute{
+				Computed:    true,
+				Description: "The issued leaf certificate, in PEM format.",
+			},
+			"ca_bundle_pem": schema.StringAttribute{
+				Computed:    true,
+				Description: "ZeroSSL's intermediate CA bundle, in PEM format.",
+			},
+		},
+	}
+}
+
+func (r *certificateValidationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected provider data", err.Error())
+		return
+	}
+	r.data = data
+}
+
+// triggerValidation asks ZeroSSL to (re)issue domain-control challenges for
+// certificateID via the given method, so it can move out of draft.
+func (r *certificateValidationResource) triggerValidation(ctx context.Context, certificateID, method string) error {
+	switch method {
+	case "HTTP_CSR_HASH", "CNAME_CSR_HASH", "EMAIL":
+	default:
+		return fmt.Errorf("unsupported validation_method %q", method)
+	}
+
+	query := url.Values{
+		"access_key":        []string{r.data.APIKey},
+		"validation_method": []string{method},
+	}
+	endpoint := fmt.Sprintf("%s/certificates/%s/challenges?%s", ZeroSSLBaseURL, url.PathEscape(certificateID), query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+
+	resp, err := r.data.HTTPClient.Do(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "could not trigger domain validation")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("triggering domain validation failed with HTTP status code %d %s",
+			resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	var challengeResponse struct {
+		Success bool     `json:"success"`
+		Error   apiError `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&challengeResponse); err != nil {
+		return errors.Wrap(err, "could not decode response")
+	}
+	if challengeResponse.Error.Code != 0 {
+		return fmt.Errorf("could not trigger domain validation; server responded with "+
+			"error type %s and error code %d", challengeResponse.Error.Type, challengeResponse.Error.Code)
+	}
+
+	return nil
+}
+
+func (r *certificateValidationResource) getStatus(ctx context.Context, certificateID string) (string, error) {
+	query := url.Values{"access_key": []string{r.data.APIKey}}
+	endpoint := fmt.Sprintf("%s/certificates/%s/status?%s", ZeroSSLBaseURL, url.PathEscape(certificateID), query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "could not create request")
+	}
+
+	resp, err := r.data.HTTPClient.Do(ctx, req)
+	if err != nil {
+		return "", errors.Wrap(err, "could not get certificate status")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("getting certificate status failed with HTTP status code %d %s",
+			resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	var statusResponse struct {
+		Status string   `json:"status"`
+		Error  apiError `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&statusResponse); err != nil {
+		return "", errors.Wrap(err, "could not decode response")
+	}
+	if statusResponse.Error.Code != 0 {
+		return "", fmt.Errorf("could not get certificate status; server responded with "+
+			"error type %s and error code %d", statusResponse.Error.Type, statusResponse.Error.Code)
+	}
+
+	return statusResponse.Status, nil
+}
+
+// waitForIssuance polls /certificates/{id}/status until certificateID
+// reaches "issued", returning an error if it instead reaches a terminal
+// failure status or the timeout elapses first.
+func (r *certificateValidationResource) waitForIssuance(ctx context.Context, certificateID string, timeout, pollInterval time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		status, err := r.getStatus(ctx, certificateID)
+		if err != nil {
+			return err
+		}
+
+		switch status {
+		case "issued":
+			return nil
+		case "cancelled", "expired":
+			return fmt.Errorf("certificate %s entered terminal status %q while waiting for validation", certificateID, status)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s waiting for certificate %s to reach issued (last status %q)",
+				timeout, certificateID, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (r *certificateValidationResource) validate(ctx context.Context, state *certificateValidationResourceModel) error {
+	certificateID := state.CertificateID.ValueString()
+
+	if err := r.triggerValidation(ctx, certificateID, state.ValidationMethod.ValueString()); err != nil {
+		return err
+	}
+
+	timeout := defaultValidationTimeout
+	if !state.Timeout.IsNull() {
+		timeout = time.Duration(state.Timeout.ValueInt64()) * time.Second
+	}
+	pollInterval := defaultValidationPollInterval
+	if !state.PollInterval.IsNull() {
+		pollInterval = time.Duration(state.PollInterval.ValueInt64()) * time.Second
+	}
+
+	if err := r.waitForIssuance(ctx, certificateID, timeout, pollInterval); err != nil {
+		return err
+	}
+
+	certPEM, caBundlePEM, err := downloadCertificate(ctx, r.data.HTTPClient, r.data.APIKey, certificateID)
+	if err != nil {
+		return err
+	}
+
+	state.ID = state.CertificateID
+	state.CertificatePEM = types.StringValue(certPEM)
+	state.CABundlePEM = types.StringValue(caBundlePEM)
+
+	return nil
+}
+
+func (r *certificateValidationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan certificateValidationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.validate(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("could not validate certificate", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *certificateValidationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state certificateValidationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *certificateValidationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan certificateValidationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorState certificateValidationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// certificate_id and validation_method require replace, so Update only
+	// ever runs for timeout/poll_interval changes, which don't affect an
+	// already-issued certificate.
+	plan.ID = priorState.ID
+	plan.CertificatePEM = priorState.CertificatePEM
+	plan.CABundlePEM = priorState.CABundlePEM
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *certificateValidationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state certificateValidationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+}
@@ -0,0 +1,15 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCertificateValidationResourceTriggerValidationRejectsUnsupportedMethod(t *testing.T) {
+	r := &certificateValidationResource{}
+
+	err := r.triggerValidation(context.Background(), "cert-id", "NOT_A_REAL_METHOD")
+	if err == nil {
+		t.Fatal("triggerValidation() with an unsupported validation_method = nil error, want error")
+	}
+}
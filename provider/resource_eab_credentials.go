@@ -6,16 +6,34 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/pkg/errors"
 )
 
-type resourceEABCredentialsType struct {
+var (
+	_ resource.Resource                = &eabCredentialsResource{}
+	_ resource.ResourceWithConfigure   = &eabCredentialsResource{}
+	_ resource.ResourceWithImportState = &eabCredentialsResource{}
+)
+
+// NewEABCredentialsResource is a resource.Resource factory for
+// zerossl_eab_credentials, as expected by zeroSSLProvider.Resources.
+func NewEABCredentialsResource() resource.Resource {
+	return &eabCredentialsResource{}
+}
+
+type eabCredentialsResource struct {
+	data *zeroSSLProviderData
+}
+
+type eabCredentialsResourceModel struct {
 	ID      types.String `tfsdk:"id"`
 	APIKey  types.String `tfsdk:"api_key"`
 	KID     types.String `tfsdk:"kid"`
@@ -32,61 +50,95 @@ type eabCredentialResponse struct {
 	} `json:"error"`
 }
 
-func (r resourceEABCredentialsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
-	return tfsdk.Schema{
-		Attributes: map[string]tfsdk.Attribute{
-			"id": {
-				Type:     types.StringType,
+func (r *eabCredentialsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eab_credentials"
+}
+
+func (r *eabCredentialsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"api_key": {
-				Type:        types.StringType,
-				Required:    true,
-				Description: "ZeroSSL API key ([View it here](https://app.zerossl.com/developer)]",
+			"api_key": schema.StringAttribute{
+				Optional: true,
+				Description: "ZeroSSL API key ([View it here](https://app.zerossl.com/developer)]. Falls back to the " +
+					"provider's `api_key` if not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"kid": {
-				Type:        types.StringType,
+			"kid": schema.StringAttribute{
 				Computed:    true,
 				Sensitive:   true,
 				Description: "kid of EAB credentials",
 			},
-			"hmac_key": {
-				Type:        types.StringType,
+			"hmac_key": schema.StringAttribute{
 				Computed:    true,
 				Sensitive:   true,
 				Description: "hmac_key of EAB credentials",
 			},
 		},
-	}, nil
+	}
 }
 
-func (r resourceEABCredentialsType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
-	return resourceEABCredentials{
-		p: *(p.(*provider)),
-	}, nil
+func (r *eabCredentialsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, err := configureFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("unexpected provider data", err.Error())
+		return
+	}
+	r.data = data
 }
 
-type resourceEABCredentials struct {
-	p provider
+// parseEABImportID splits an import ID of the form "<api_key>:<uuid>" into
+// its api_key and id parts.
+func parseEABImportID(importID string) (apiKey, id string, err error) {
+	apiKey, id, found := strings.Cut(importID, ":")
+	if !found || apiKey == "" || id == "" {
+		return "", "", fmt.Errorf(`expected an import ID of the form "<api_key>:<uuid>"`)
+	}
+	return apiKey, id, nil
 }
 
-func (r resourceEABCredentials) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
-}
+// ImportState accepts an import ID of the form "<api_key>:<uuid>". The API
+// key is required because, unlike most resources, the import ID alone
+// carries no credential ZeroSSL will accept to regenerate kid/hmac_key -
+// without it, Read would have to return empty sensitive values and the next
+// plan would show a spurious replace.
+func (r *eabCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	apiKey, id, err := parseEABImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("invalid import ID", err.Error())
+		return
+	}
 
-func (r resourceEABCredentials) updateState(resourceState *resourceEABCredentialsType) error {
-	if resourceState.ID.Unknown {
-		resourceState.ID = types.String{Value: uuid.Must(uuid.NewRandom()).String()}
+	state := eabCredentialsResourceModel{
+		ID:     types.StringValue(id),
+		APIKey: types.StringValue(apiKey),
 	}
 
-	return nil
+	if err := r.generateEABCredentials(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("could not generate EAB credentials", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r resourceEABCredentials) GenerateEABCredentials(
+func (r *eabCredentialsResource) generateEABCredentials(
 	ctx context.Context,
-	resourceState *resourceEABCredentialsType,
+	state *eabCredentialsResourceModel,
 ) error {
-	query := url.Values{"access_key": []string{resourceState.APIKey.Value}}
+	apiKey, err := resolveAPIKey(state.APIKey, r.data.APIKey)
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{"access_key": []string{apiKey}}
 	endpoint := fmt.Sprintf("%s/acme/eab-credentials?%s", ZeroSSLBaseURL, query.Encode())
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, nil)
@@ -94,7 +146,7 @@ func (r resourceEABCredentials) GenerateEABCredentials(
 		return errors.Wrap(err, "could not create request")
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := r.data.HTTPClient.Do(ctx, req)
 	if err != nil {
 		return errors.Wrap(err, "could not request EAB credentials")
 	}
@@ -117,78 +169,76 @@ func (r resourceEABCredentials) GenerateEABCredentials(
 			eabResponse.Error.Type, eabResponse.Error.Code, resp.StatusCode, http.StatusText(resp.StatusCode))
 	}
 
-	resourceState.KID = types.String{Value: eabResponse.KID}
-	resourceState.HMACKey = types.String{Value: eabResponse.HMACKey}
+	state.KID = types.StringValue(eabResponse.KID)
+	state.HMACKey = types.StringValue(eabResponse.HMACKey)
 
 	return nil
 }
 
-func (r resourceEABCredentials) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
-	resourceState := resourceEABCredentialsType{}
-	diags := req.Config.Get(ctx, &resourceState)
-	resp.Diagnostics.Append(diags...)
+func (r *eabCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state eabCredentialsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.GenerateEABCredentials(ctx, &resourceState); err != nil {
-		resp.Diagnostics.AddError("could not generate EAB credentials", err.Error())
-		return
-	}
+	state.ID = types.StringValue(uuid.Must(uuid.NewRandom()).String())
 
-	if resp.Diagnostics.HasError() {
+	if err := r.generateEABCredentials(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("could not generate EAB credentials", err.Error())
 		return
 	}
 
-	diags = resp.State.Set(ctx, &resourceState)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r resourceEABCredentials) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
-	var resourceState resourceEABCredentialsType
-	diags := req.State.Get(ctx, &resourceState)
-	resp.Diagnostics.Append(diags...)
+func (r *eabCredentialsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state eabCredentialsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	diags = resp.State.Set(ctx, &resourceState)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if credentialsMissing(state) {
+		if err := r.generateEABCredentials(ctx, &state); err != nil {
+			resp.Diagnostics.AddError("could not generate EAB credentials", err.Error())
+			return
+		}
 	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r resourceEABCredentials) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
-	var plan resourceEABCredentialsType
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+// credentialsMissing reports whether kid/hmac_key still need to be computed,
+// i.e. whether either is unknown or empty. Create and ImportState both fill
+// them in, so this only holds for state written without them.
+func credentialsMissing(state eabCredentialsResourceModel) bool {
+	return state.KID.IsUnknown() || state.KID.ValueString() == "" ||
+		state.HMACKey.IsUnknown() || state.HMACKey.ValueString() == ""
+}
+
+func (r *eabCredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan eabCredentialsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.GenerateEABCredentials(ctx, &plan); err != nil {
-		resp.Diagnostics.AddError("could not generate EAB credentials", err.Error())
-		return
+	// api_key requires replace, so the only way Update runs is for
+	// attributes outside this resource's control; regenerate kid/hmac_key
+	// only if they're somehow still missing, rather than unconditionally
+	// churning them (and ZeroSSL's rate limits) on every apply.
+	if credentialsMissing(plan) {
+		if err := r.generateEABCredentials(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("could not generate EAB credentials", err.Error())
+			return
+		}
 	}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func (r resourceEABCredentials) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
-	var state resourceEABCredentialsType
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.State.RemoveResource(ctx)
+func (r *eabCredentialsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state eabCredentialsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 }
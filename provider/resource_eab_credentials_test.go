@@ -0,0 +1,54 @@
+package provider
+
+import "testing"
+
+func TestParseEABImportID(t *testing.T) {
+	tests := []struct {
+		name       string
+		importID   string
+		wantAPIKey string
+		wantID     string
+		wantErr    bool
+	}{
+		{
+			name:       "valid",
+			importID:   "abc123:4f9c9d2e-4d2b-4c1e-9c1a-2f6a1a0e1b2c",
+			wantAPIKey: "abc123",
+			wantID:     "4f9c9d2e-4d2b-4c1e-9c1a-2f6a1a0e1b2c",
+		},
+		{
+			name:     "missing separator",
+			importID: "abc1234f9c9d2e",
+			wantErr:  true,
+		},
+		{
+			name:     "missing api key",
+			importID: ":4f9c9d2e-4d2b-4c1e-9c1a-2f6a1a0e1b2c",
+			wantErr:  true,
+		},
+		{
+			name:     "missing id",
+			importID: "abc123:",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKey, id, err := parseEABImportID(tt.importID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseEABImportID(%q) = nil error, want error", tt.importID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseEABImportID(%q) returned unexpected error: %v", tt.importID, err)
+			}
+			if apiKey != tt.wantAPIKey || id != tt.wantID {
+				t.Fatalf("parseEABImportID(%q) = (%q, %q), want (%q, %q)",
+					tt.importID, apiKey, id, tt.wantAPIKey, tt.wantID)
+			}
+		})
+	}
+}